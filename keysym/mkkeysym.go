@@ -0,0 +1,102 @@
+//go:build ignore
+// +build ignore
+
+// mkkeysym generates the KeySym constants and name tables for the keysym
+// package from X11's keysymdef.h.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// define matches a keysym definition in keysymdef.h.
+var define = regexp.MustCompile(`^#define XK_([a-zA-Z_0-9]+)\s+0x([0-9a-fA-F]+)\s*(?:/\*\s*(.*?)\s*\*/)?\s*$`)
+
+// A sym is one definition from the header.
+type sym struct {
+	name, value, comment string
+}
+
+// parse reads the keysym definitions from the header filename, in order.
+func parse(filename string) ([]sym, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var syms []sym
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		m := define.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+		syms = append(syms, sym{m[1], strings.ToLower(m[2]), m[3]})
+	}
+	return syms, s.Err()
+}
+
+// generate writes the Go source for syms, read from the header source.
+func generate(syms []sym, source string) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by mkkeysym.go from %s; DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&b, "package keysym\n\n")
+
+	fmt.Fprintf(&b, "// Known KeySyms from %s\n", source)
+	fmt.Fprintf(&b, "const (\n")
+	for _, s := range syms {
+		fmt.Fprintf(&b, "XK_%s = 0x%s", s.name, s.value)
+		if s.comment != "" {
+			fmt.Fprintf(&b, " // %s", s.comment)
+		}
+		fmt.Fprintf(&b, "\n")
+	}
+	fmt.Fprintf(&b, ")\n\n")
+
+	fmt.Fprintf(&b, "// names maps every name in %s, without the XK_ prefix, to its\n", source)
+	fmt.Fprintf(&b, "// KeySym.\n")
+	fmt.Fprintf(&b, "var names = map[string]Keysym{\n")
+	for _, s := range syms {
+		fmt.Fprintf(&b, "%q: XK_%s,\n", s.name, s.name)
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// symNames maps every KeySym to its preferred name.\n")
+	fmt.Fprintf(&b, "var symNames = map[Keysym]string{\n")
+	seen := make(map[string]bool)
+	for _, s := range syms {
+		if seen[s.value] || s.comment == "deprecated" {
+			continue
+		}
+		seen[s.value] = true
+		fmt.Fprintf(&b, "XK_%s: %q,\n", s.name, s.name)
+	}
+	fmt.Fprintf(&b, "}\n")
+	return format.Source(b.Bytes())
+}
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatal("usage: go run mkkeysym.go keysymdef.h output.go")
+	}
+	syms, err := parse(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(syms, os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(os.Args[2], src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,118 @@
+package keysym
+
+// Known KeySyms from /usr/include/X11/XF86keysym.h
+const (
+	XF86XK_MonBrightnessUp   = 0x1008ff02 // Monitor/panel brightness
+	XF86XK_MonBrightnessDown = 0x1008ff03 // Monitor/panel brightness
+	XF86XK_KbdLightOnOff     = 0x1008ff04 // Keyboards may be lit
+	XF86XK_KbdBrightnessUp   = 0x1008ff05 // Keyboards may be lit
+	XF86XK_KbdBrightnessDown = 0x1008ff06 // Keyboards may be lit
+	XF86XK_AudioLowerVolume  = 0x1008ff11 // Volume control down
+	XF86XK_AudioMute         = 0x1008ff12 // Mute sound from the system
+	XF86XK_AudioRaiseVolume  = 0x1008ff13 // Volume control up
+	XF86XK_AudioPlay         = 0x1008ff14 // Start playing of audio
+	XF86XK_AudioStop         = 0x1008ff15 // Stop playing audio
+	XF86XK_AudioPrev         = 0x1008ff16 // Previous track
+	XF86XK_AudioNext         = 0x1008ff17 // Next track
+	XF86XK_HomePage          = 0x1008ff18 // Display user's home page
+	XF86XK_Mail              = 0x1008ff19 // Invoke user's mail program
+	XF86XK_Search            = 0x1008ff1b // Search
+	XF86XK_AudioRecord       = 0x1008ff1c // Record audio application
+	XF86XK_Calculator        = 0x1008ff1d // Invoke calculator program
+	XF86XK_PowerOff          = 0x1008ff2a // Power off system entirely
+	XF86XK_Eject             = 0x1008ff2c // Eject device (e.g. DVD)
+	XF86XK_ScreenSaver       = 0x1008ff2d // Invoke screensaver
+	XF86XK_WWW               = 0x1008ff2e // Invoke web browser
+	XF86XK_Sleep             = 0x1008ff2f // Put system to sleep
+	XF86XK_Favorites         = 0x1008ff30 // Show favorite locations
+	XF86XK_AudioPause        = 0x1008ff31 // Pause audio playing
+	XF86XK_AudioMedia        = 0x1008ff32 // Launch media collection app
+	XF86XK_MyComputer        = 0x1008ff33 // Display "My Computer" window
+	XF86XK_AudioRewind       = 0x1008ff3e // "rewind" audio track
+	XF86XK_Launch0           = 0x1008ff40 // Launch Application
+	XF86XK_Launch1           = 0x1008ff41 // Launch Application
+	XF86XK_Launch2           = 0x1008ff42 // Launch Application
+	XF86XK_Launch3           = 0x1008ff43 // Launch Application
+	XF86XK_Launch4           = 0x1008ff44 // Launch Application
+	XF86XK_Launch5           = 0x1008ff45 // Launch Application
+	XF86XK_Launch6           = 0x1008ff46 // Launch Application
+	XF86XK_Launch7           = 0x1008ff47 // Launch Application
+	XF86XK_Launch8           = 0x1008ff48 // Launch Application
+	XF86XK_Launch9           = 0x1008ff49 // Launch Application
+	XF86XK_LaunchA           = 0x1008ff4a // Launch Application
+	XF86XK_LaunchB           = 0x1008ff4b // Launch Application
+	XF86XK_LaunchC           = 0x1008ff4c // Launch Application
+	XF86XK_LaunchD           = 0x1008ff4d // Launch Application
+	XF86XK_LaunchE           = 0x1008ff4e // Launch Application
+	XF86XK_LaunchF           = 0x1008ff4f // Launch Application
+	XF86XK_Display           = 0x1008ff59 // Output switch key
+	XF86XK_Explorer          = 0x1008ff5d // Launch file manager
+	XF86XK_Tools             = 0x1008ff81 // toolbox of desktop/app.
+	XF86XK_Battery           = 0x1008ff93 // Display battery information
+	XF86XK_Bluetooth         = 0x1008ff94 // Enable/disable Bluetooth
+	XF86XK_WLAN              = 0x1008ff95 // Enable/disable WLAN
+	XF86XK_AudioForward      = 0x1008ff97 // fast-forward audio track
+	XF86XK_AudioRepeat       = 0x1008ff98 // toggle repeat mode
+	XF86XK_AudioRandomPlay   = 0x1008ff99 // toggle shuffle mode
+	XF86XK_TouchpadToggle    = 0x1008ffa9 // Toggle between touchpad/trackstick
+	XF86XK_AudioMicMute      = 0x1008ffb2 // Mute the Mic from the system
+)
+
+// xf86Names maps the names of the KeySyms from XF86keysym.h, with the
+// "XK_" removed like xev shows them, to their KeySyms.
+var xf86Names = map[string]Keysym{
+	"XF86MonBrightnessUp":   XF86XK_MonBrightnessUp,
+	"XF86MonBrightnessDown": XF86XK_MonBrightnessDown,
+	"XF86KbdLightOnOff":     XF86XK_KbdLightOnOff,
+	"XF86KbdBrightnessUp":   XF86XK_KbdBrightnessUp,
+	"XF86KbdBrightnessDown": XF86XK_KbdBrightnessDown,
+	"XF86AudioLowerVolume":  XF86XK_AudioLowerVolume,
+	"XF86AudioMute":         XF86XK_AudioMute,
+	"XF86AudioRaiseVolume":  XF86XK_AudioRaiseVolume,
+	"XF86AudioPlay":         XF86XK_AudioPlay,
+	"XF86AudioStop":         XF86XK_AudioStop,
+	"XF86AudioPrev":         XF86XK_AudioPrev,
+	"XF86AudioNext":         XF86XK_AudioNext,
+	"XF86HomePage":          XF86XK_HomePage,
+	"XF86Mail":              XF86XK_Mail,
+	"XF86Search":            XF86XK_Search,
+	"XF86AudioRecord":       XF86XK_AudioRecord,
+	"XF86Calculator":        XF86XK_Calculator,
+	"XF86PowerOff":          XF86XK_PowerOff,
+	"XF86Eject":             XF86XK_Eject,
+	"XF86ScreenSaver":       XF86XK_ScreenSaver,
+	"XF86WWW":               XF86XK_WWW,
+	"XF86Sleep":             XF86XK_Sleep,
+	"XF86Favorites":         XF86XK_Favorites,
+	"XF86AudioPause":        XF86XK_AudioPause,
+	"XF86AudioMedia":        XF86XK_AudioMedia,
+	"XF86MyComputer":        XF86XK_MyComputer,
+	"XF86AudioRewind":       XF86XK_AudioRewind,
+	"XF86Launch0":           XF86XK_Launch0,
+	"XF86Launch1":           XF86XK_Launch1,
+	"XF86Launch2":           XF86XK_Launch2,
+	"XF86Launch3":           XF86XK_Launch3,
+	"XF86Launch4":           XF86XK_Launch4,
+	"XF86Launch5":           XF86XK_Launch5,
+	"XF86Launch6":           XF86XK_Launch6,
+	"XF86Launch7":           XF86XK_Launch7,
+	"XF86Launch8":           XF86XK_Launch8,
+	"XF86Launch9":           XF86XK_Launch9,
+	"XF86LaunchA":           XF86XK_LaunchA,
+	"XF86LaunchB":           XF86XK_LaunchB,
+	"XF86LaunchC":           XF86XK_LaunchC,
+	"XF86LaunchD":           XF86XK_LaunchD,
+	"XF86LaunchE":           XF86XK_LaunchE,
+	"XF86LaunchF":           XF86XK_LaunchF,
+	"XF86Display":           XF86XK_Display,
+	"XF86Explorer":          XF86XK_Explorer,
+	"XF86Tools":             XF86XK_Tools,
+	"XF86Battery":           XF86XK_Battery,
+	"XF86Bluetooth":         XF86XK_Bluetooth,
+	"XF86WLAN":              XF86XK_WLAN,
+	"XF86AudioForward":      XF86XK_AudioForward,
+	"XF86AudioRepeat":       XF86XK_AudioRepeat,
+	"XF86AudioRandomPlay":   XF86XK_AudioRandomPlay,
+	"XF86TouchpadToggle":    XF86XK_TouchpadToggle,
+	"XF86AudioMicMute":      XF86XK_AudioMicMute,
+}